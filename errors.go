@@ -12,15 +12,6 @@ var LoPanic = func(e any) { panic(e) }
 // LoErrorF is user custom error. example wrap error stack.
 var LoErrorF = fmt.Errorf
 
-// Validate is a helper that creates an error when a condition is not met.
-// Play: https://go.dev/play/p/vPyh51XpCBt
-func Validate(ok bool, format string, args ...any) error {
-	if !ok {
-		return LoErrorF(fmt.Sprintf(format, args...))
-	}
-	return nil
-}
-
 func messageFromMsgAndArgs(msgAndArgs ...interface{}) string {
 	if len(msgAndArgs) == 1 {
 		if msgAsStr, ok := msgAndArgs[0].(string); ok {
@@ -48,16 +39,20 @@ func must(err any, messageArgs ...interface{}) {
 				message = "not ok"
 			}
 
+			if LoCaptureStack {
+				LoPanic(newStackErr(fmt.Errorf("%s: %w", message, ErrMustFailed), "", skipViaMust))
+				return
+			}
 			LoPanic(message)
 		}
 
 	case error:
 		message := messageFromMsgAndArgs(messageArgs...)
-		if message != "" {
-			LoPanic(message + ": " + e.Error())
-		} else {
-			LoPanic(e.Error())
+		base := error(e)
+		if LoCaptureStack {
+			base = newStackErr(base, "", skipViaMust)
 		}
+		LoPanic(wrapErrPrefixMsg{Base: base, Attach: message})
 
 	default:
 		LoPanic("must: invalid err type '" + reflect.TypeOf(err).Name() + "', should either be a bool or an error")
@@ -136,12 +131,18 @@ func (err wrapErrPrefixMsg) String() string {
 func (err wrapErrPrefixMsg) Unwrap() error {
 	return err.Base
 }
+func (err wrapErrPrefixMsg) Is(target error) bool {
+	return errors.Is(err.Base, target)
+}
 
 func mustE(err error, messageArgs ...any) {
 	if err == nil {
 		return
 	}
 	message := messageFromMsgAndArgs(messageArgs...)
+	if LoCaptureStack {
+		err = newStackErr(err, "", skipViaMust)
+	}
 	LoPanic(wrapErrPrefixMsg{Base: err, Attach: message})
 }
 
@@ -430,3 +431,29 @@ func ErrorsAs[T error](err error) (T, bool) {
 	ok := errors.As(err, &t)
 	return t, ok
 }
+
+// ErrorsIs is a shortcut for errors.Is(err, target).
+func ErrorsIs(err, target error) bool {
+	return errors.Is(err, target)
+}
+
+// ErrMustFailed is the sentinel wrapped around panics raised by the bool
+// path of must (Must, Must0, Must1, ...), so that a failure surfaced
+// through TryE/TryCatchE/TryOrErr* can be classified with
+// errors.Is(err, lo.ErrMustFailed).
+var ErrMustFailed = errors.New("must: assertion failed")
+
+// AsError converts the value returned by recover() into an error: a string
+// becomes errors.New(string), an error is returned as-is, and anything else
+// is formatted with "%+v". It gives code bridging TryWithErrorValue into
+// errors.Is/errors.As a single canonical conversion path.
+func AsError(v any) error {
+	switch e := v.(type) {
+	case error:
+		return e
+	case string:
+		return errors.New(e)
+	default:
+		return fmt.Errorf("%+v", e)
+	}
+}
@@ -0,0 +1,142 @@
+package lo
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+)
+
+// LoCaptureStack controls whether must/mustE capture a stack trace (as a
+// stackErr) in the value they panic with, on both the error and the bool
+// path. Off by default so existing callers keep seeing the same panic values.
+var LoCaptureStack = false
+
+const maxStackDepth = 32
+
+// skipDirect is the runtime.Callers skip count for WithStack/Wrap called
+// directly by user code. skipViaMust accounts for the extra must/mustE and
+// MustN/MustEN frames so the captured trace still starts at the user's call
+// site instead of inside this package. Must1 calls Must, which adds one more
+// frame, so a trace captured through Must1 starts at Must1.
+const (
+	skipDirect  = 3
+	skipViaMust = 4
+)
+
+// stackErr wraps an error with the call stack captured at construction. It
+// implements error, Unwrap and fmt.Formatter, modeled on the well-known
+// github.com/pkg/errors pattern: "%+v" prints the message followed by a
+// file:line frame list, while "%s"/"%v" print only the message.
+type stackErr struct {
+	msg   string
+	cause error
+	pcs   []uintptr
+}
+
+func newStackErr(cause error, msg string, skip int) *stackErr {
+	pcs := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(skip, pcs)
+	return &stackErr{msg: msg, cause: cause, pcs: pcs[:n]}
+}
+
+func (e *stackErr) Error() string {
+	if e.msg == "" {
+		return e.cause.Error()
+	}
+	return e.msg + ": " + e.cause.Error()
+}
+
+func (e *stackErr) Unwrap() error {
+	return e.cause
+}
+
+func (e *stackErr) frames() []runtime.Frame {
+	frames := runtime.CallersFrames(e.pcs)
+	out := make([]runtime.Frame, 0, len(e.pcs))
+	for {
+		frame, more := frames.Next()
+		out = append(out, frame)
+		if !more {
+			break
+		}
+	}
+	return out
+}
+
+// Format implements fmt.Formatter. "%+v" prints the message followed by a
+// file:line frame list, any other verb prints just the message.
+func (e *stackErr) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('+') {
+		io.WriteString(f, e.Error())
+		for _, fr := range e.frames() {
+			if fr.Function == "" {
+				continue
+			}
+			fmt.Fprintf(f, "\n\t%s\n\t\t%s:%d", fr.Function, fr.File, fr.Line)
+		}
+		return
+	}
+	io.WriteString(f, e.Error())
+}
+
+// Format implements fmt.Formatter for wrapErrPrefixMsg, deferring to Base's
+// own formatting when it is a stackErr so that "%+v" still prints frames.
+func (err wrapErrPrefixMsg) Format(f fmt.State, verb rune) {
+	if se, ok := err.Base.(*stackErr); ok {
+		if err.Attach != "" {
+			io.WriteString(f, err.Attach+": ")
+		}
+		se.Format(f, verb)
+		return
+	}
+	io.WriteString(f, err.Error())
+}
+
+// WithStack wraps err in a stackErr capturing the caller's stack trace.
+// WithStack(nil) returns nil. If err is itself a stack-carrying error from
+// WithStack or Wrap, it is returned unchanged; an error that merely wraps
+// one is wrapped again with a new stack.
+func WithStack(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*stackErr); ok {
+		return err
+	}
+	return newStackErr(err, "", skipDirect)
+}
+
+// Wrap annotates err with a message and captures the caller's stack trace,
+// à la github.com/pkg/errors. Wrap(nil, ...) returns nil.
+func Wrap(err error, msg string, args ...any) error {
+	if err == nil {
+		return nil
+	}
+	if len(args) > 0 {
+		msg = fmt.Sprintf(msg, args...)
+	}
+	return newStackErr(err, msg, skipDirect)
+}
+
+// Cause walks Unwrap until it reaches the root error.
+func Cause(err error) error {
+	for {
+		unwrapped := errors.Unwrap(err)
+		if unwrapped == nil {
+			return err
+		}
+		err = unwrapped
+	}
+}
+
+// StackFrames returns the call frames captured for err (or an error it
+// wraps), or nil if none of them carry a stack, so monitoring code can
+// export structured frames without reparsing "%+v".
+func StackFrames(err error) []runtime.Frame {
+	var se *stackErr
+	if !errors.As(err, &se) {
+		return nil
+	}
+	return se.frames()
+}
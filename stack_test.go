@@ -0,0 +1,102 @@
+package lo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithStackNil(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Nil(WithStack(nil))
+}
+
+func TestWrapNil(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Nil(Wrap(nil, "msg"))
+}
+
+func TestWithStackFrames(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	err := errors.New("boom")
+	wrapped := WithStack(err)
+	is.ErrorIs(wrapped, err)
+
+	frames := StackFrames(wrapped)
+	is.NotEmpty(frames)
+	is.Contains(frames[0].Function, "TestWithStackFrames")
+}
+
+func TestWrapFrames(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	err := errors.New("boom")
+	wrapped := Wrap(err, "context: %d", 42)
+	is.ErrorIs(wrapped, err)
+	is.Equal("context: 42: boom", wrapped.Error())
+
+	frames := StackFrames(wrapped)
+	is.NotEmpty(frames)
+	is.Contains(frames[0].Function, "TestWrapFrames")
+}
+
+func TestStackFramesNoStack(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Nil(StackFrames(errors.New("boom")))
+}
+
+func TestCause(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	root := errors.New("root")
+	wrapped := Wrap(root, "outer")
+	is.Equal(root, Cause(wrapped))
+	is.Equal(root, Cause(root))
+}
+
+func TestStackErrFormat(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	err := Wrap(errors.New("boom"), "context")
+
+	is.Equal("context: boom", fmt.Sprintf("%s", err))
+	is.Equal("context: boom", fmt.Sprintf("%v", err))
+
+	full := fmt.Sprintf("%+v", err)
+	is.True(strings.HasPrefix(full, "context: boom"))
+	is.Contains(full, "TestStackErrFormat")
+}
+
+func TestLoCaptureStackWiresIntoMust(t *testing.T) {
+	is := assert.New(t)
+
+	prev := LoCaptureStack
+	LoCaptureStack = true
+	defer func() { LoCaptureStack = prev }()
+
+	sentinelErr := errors.New("sentinel")
+
+	err := TryE(func() error {
+		Must0(sentinelErr)
+		return nil
+	})
+	is.ErrorIs(err, sentinelErr)
+
+	frames := StackFrames(err)
+	is.NotEmpty(frames)
+	is.Contains(frames[0].Function, "TestLoCaptureStackWiresIntoMust")
+}
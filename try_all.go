@@ -0,0 +1,120 @@
+package lo
+
+import (
+	"strings"
+	"sync"
+)
+
+// multiErr aggregates multiple errors (and recovered panics, converted to
+// errors) into a single error. It implements Unwrap() []error so that
+// errors.Is and errors.As can reach any one of the wrapped errors, matching
+// the multi-error behavior of errors.Join.
+type multiErr struct {
+	errs []error
+}
+
+func (m *multiErr) Error() string {
+	msgs := make([]string, 0, len(m.errs))
+	for _, err := range m.errs {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap exposes every aggregated error so errors.Is/errors.As can iterate
+// over them in order.
+func (m *multiErr) Unwrap() []error {
+	return m.errs
+}
+
+func joinErrs(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return &multiErr{errs: errs}
+}
+
+func recoverToErr(r any) error {
+	return AsError(r)
+}
+
+func runCallbackCollectErr(callback func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoverToErr(r)
+		}
+	}()
+
+	return callback()
+}
+
+// TryAll runs every callback in sequence, recovering panics along the way,
+// and reports whether all of them completed without error or panic.
+func TryAll(callbacks ...func() error) bool {
+	return TryAllWithErrors(callbacks...) == nil
+}
+
+// TryAllWithErrors runs every callback in sequence, recovering panics along
+// the way, and aggregates every non-nil error and recovered panic into a
+// single error implementing Unwrap() []error. It returns nil when every
+// callback succeeded.
+func TryAllWithErrors(callbacks ...func() error) error {
+	errs := make([]error, 0, len(callbacks))
+
+	for _, callback := range callbacks {
+		if err := runCallbackCollectErr(callback); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return joinErrs(errs)
+}
+
+// TryAllParallel has the same behavior as TryAllWithErrors, but runs the
+// callbacks concurrently across a pool of `workers` goroutines. A workers
+// value <= 0 runs every callback in its own goroutine.
+func TryAllParallel(workers int, callbacks ...func() error) error {
+	if len(callbacks) == 0 {
+		return nil
+	}
+	if workers <= 0 || workers > len(callbacks) {
+		workers = len(callbacks)
+	}
+
+	indexes := make(chan int)
+	results := make([]error, len(callbacks))
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for idx := range indexes {
+				results[idx] = runCallbackCollectErr(callbacks[idx])
+			}
+		}()
+	}
+
+	for i := range callbacks {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	errs := make([]error, 0, len(results))
+	for _, err := range results {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return joinErrs(errs)
+}
+
+// MustAll has the same behavior as TryAllWithErrors, but panics through
+// LoPanic with the aggregated error when at least one callback failed.
+func MustAll(callbacks ...func() error) {
+	if err := TryAllWithErrors(callbacks...); err != nil {
+		LoPanic(err)
+	}
+}
@@ -0,0 +1,94 @@
+package lo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTryAll(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.True(TryAll(
+		func() error { return nil },
+		func() error { return nil },
+	))
+
+	is.False(TryAll(
+		func() error { return nil },
+		func() error { return assert.AnError },
+	))
+
+	is.False(TryAll(
+		func() error { panic("ko") },
+	))
+}
+
+func TestTryAllWithErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.NoError(TryAllWithErrors(
+		func() error { return nil },
+		func() error { return nil },
+	))
+
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+
+	err := TryAllWithErrors(
+		func() error { return nil },
+		func() error { return err1 },
+		func() error { panic(err2) },
+	)
+
+	is.Error(err)
+	is.True(errors.Is(err, err1))
+	is.True(errors.Is(err, err2))
+
+	var multi interface{ Unwrap() []error }
+	is.True(errors.As(err, &multi))
+	is.Len(multi.Unwrap(), 2)
+}
+
+func TestTryAllParallel(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.NoError(TryAllParallel(0))
+
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+
+	err := TryAllParallel(2,
+		func() error { return nil },
+		func() error { return err1 },
+		func() error { panic(err2) },
+		func() error { return nil },
+	)
+
+	is.Error(err)
+	is.True(errors.Is(err, err1))
+	is.True(errors.Is(err, err2))
+}
+
+func TestMustAll(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.NotPanics(func() {
+		MustAll(
+			func() error { return nil },
+			func() error { return nil },
+		)
+	})
+
+	is.Panics(func() {
+		MustAll(
+			func() error { return nil },
+			func() error { return assert.AnError },
+		)
+	})
+}
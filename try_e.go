@@ -0,0 +1,136 @@
+package lo
+
+import "fmt"
+
+// recoverToMustErr converts the value recovered from a panic raised by
+// must/mustE (or a plain panic inside the wrapped callback) into an error,
+// preserving Unwrap when the panic came from mustE's error path.
+func recoverToMustErr(r any) error {
+	if e, ok := r.(wrapErrPrefixMsg); ok {
+		return e
+	}
+	if s, ok := r.(string); ok {
+		return fmt.Errorf("%s: %w", s, ErrMustFailed)
+	}
+	return AsError(r)
+}
+
+// TryE has the same behavior as Try, but returns the underlying error
+// instead of collapsing it to a bool, so callers can use errors.Is/errors.As
+// to classify the failure of a function wrapped by Must.
+func TryE(callback func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoverToMustErr(r)
+		}
+	}()
+
+	return callback()
+}
+
+// TryCatchE has the same behavior as TryCatch, but passes the underlying
+// error to catch instead of discarding it.
+func TryCatchE(callback func() error, catch func(error)) {
+	if err := TryE(callback); err != nil {
+		catch(err)
+	}
+}
+
+// TryOrErr1 has the same behavior as TryOr1, but returns the underlying
+// error instead of a bool.
+func TryOrErr1[A any](callback func() (A, error), fallbackA A) (A, error) {
+	err := TryE(func() error {
+		a, err := callback()
+		if err == nil {
+			fallbackA = a
+		}
+		return err
+	})
+
+	return fallbackA, err
+}
+
+// TryOrErr2 has the same behavior as TryOr2, but returns the underlying
+// error instead of a bool.
+func TryOrErr2[A any, B any](callback func() (A, B, error), fallbackA A, fallbackB B) (A, B, error) {
+	err := TryE(func() error {
+		a, b, err := callback()
+		if err == nil {
+			fallbackA = a
+			fallbackB = b
+		}
+		return err
+	})
+
+	return fallbackA, fallbackB, err
+}
+
+// TryOrErr3 has the same behavior as TryOr3, but returns the underlying
+// error instead of a bool.
+func TryOrErr3[A any, B any, C any](callback func() (A, B, C, error), fallbackA A, fallbackB B, fallbackC C) (A, B, C, error) {
+	err := TryE(func() error {
+		a, b, c, err := callback()
+		if err == nil {
+			fallbackA = a
+			fallbackB = b
+			fallbackC = c
+		}
+		return err
+	})
+
+	return fallbackA, fallbackB, fallbackC, err
+}
+
+// TryOrErr4 has the same behavior as TryOr4, but returns the underlying
+// error instead of a bool.
+func TryOrErr4[A any, B any, C any, D any](callback func() (A, B, C, D, error), fallbackA A, fallbackB B, fallbackC C, fallbackD D) (A, B, C, D, error) {
+	err := TryE(func() error {
+		a, b, c, d, err := callback()
+		if err == nil {
+			fallbackA = a
+			fallbackB = b
+			fallbackC = c
+			fallbackD = d
+		}
+		return err
+	})
+
+	return fallbackA, fallbackB, fallbackC, fallbackD, err
+}
+
+// TryOrErr5 has the same behavior as TryOr5, but returns the underlying
+// error instead of a bool.
+func TryOrErr5[A any, B any, C any, D any, E any](callback func() (A, B, C, D, E, error), fallbackA A, fallbackB B, fallbackC C, fallbackD D, fallbackE E) (A, B, C, D, E, error) {
+	err := TryE(func() error {
+		a, b, c, d, e, err := callback()
+		if err == nil {
+			fallbackA = a
+			fallbackB = b
+			fallbackC = c
+			fallbackD = d
+			fallbackE = e
+		}
+		return err
+	})
+
+	return fallbackA, fallbackB, fallbackC, fallbackD, fallbackE, err
+}
+
+// TryOrErr6 has the same behavior as TryOr6, but returns the underlying
+// error instead of a bool.
+func TryOrErr6[A any, B any, C any, D any, E any, F any](callback func() (A, B, C, D, E, F, error), fallbackA A, fallbackB B, fallbackC C, fallbackD D, fallbackE E, fallbackF F) (A, B, C, D, E, F, error) {
+	err := TryE(func() error {
+		a, b, c, d, e, f, err := callback()
+		if err == nil {
+			fallbackA = a
+			fallbackB = b
+			fallbackC = c
+			fallbackD = d
+			fallbackE = e
+			fallbackF = f
+		}
+		return err
+	})
+
+	return fallbackA, fallbackB, fallbackC, fallbackD, fallbackE, fallbackF, err
+}
@@ -0,0 +1,170 @@
+package lo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTryE(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.NoError(TryE(func() error { return nil }))
+
+	sentinelErr := errors.New("sentinel")
+	is.ErrorIs(TryE(func() error { return sentinelErr }), sentinelErr)
+
+	is.ErrorIs(TryE(func() error { panic(sentinelErr) }), sentinelErr)
+
+	err := TryE(func() error { panic("ko") })
+	is.Error(err)
+	is.ErrorIs(err, ErrMustFailed)
+}
+
+func TestTryEMust(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	sentinelErr := errors.New("sentinel")
+
+	err := TryE(func() error {
+		Must0(sentinelErr)
+		return nil
+	})
+	is.ErrorIs(err, sentinelErr)
+
+	err = TryE(func() error {
+		Must0(false)
+		return nil
+	})
+	is.ErrorIs(err, ErrMustFailed)
+
+	prev := LoCaptureStack
+	LoCaptureStack = true
+	defer func() { LoCaptureStack = prev }()
+
+	err = TryE(func() error {
+		Must0(sentinelErr)
+		return nil
+	})
+	is.ErrorIs(err, sentinelErr)
+}
+
+func TestTryCatchE(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	sentinelErr := errors.New("sentinel")
+	var caught error
+
+	TryCatchE(func() error { return nil }, func(err error) { caught = err })
+	is.Nil(caught)
+
+	TryCatchE(func() error { return sentinelErr }, func(err error) { caught = err })
+	is.ErrorIs(caught, sentinelErr)
+}
+
+func TestTryOrErr1(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	sentinelErr := errors.New("sentinel")
+
+	val, err := TryOrErr1(func() (int, error) { return 42, nil }, 0)
+	is.Equal(42, val)
+	is.NoError(err)
+
+	val, err = TryOrErr1(func() (int, error) { return 0, sentinelErr }, 13)
+	is.Equal(13, val)
+	is.ErrorIs(err, sentinelErr)
+}
+
+func TestTryOrErr2(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	sentinelErr := errors.New("sentinel")
+
+	a, b, err := TryOrErr2(func() (int, string, error) { return 1, "a", nil }, 0, "")
+	is.Equal(1, a)
+	is.Equal("a", b)
+	is.NoError(err)
+
+	a, b, err = TryOrErr2(func() (int, string, error) { return 0, "", sentinelErr }, 2, "b")
+	is.Equal(2, a)
+	is.Equal("b", b)
+	is.ErrorIs(err, sentinelErr)
+}
+
+func TestTryOrErr3(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	sentinelErr := errors.New("sentinel")
+
+	a, b, c, err := TryOrErr3(func() (int, int, int, error) { return 1, 2, 3, nil }, 0, 0, 0)
+	is.Equal([3]int{1, 2, 3}, [3]int{a, b, c})
+	is.NoError(err)
+
+	a, b, c, err = TryOrErr3(func() (int, int, int, error) { return 0, 0, 0, sentinelErr }, 4, 5, 6)
+	is.Equal([3]int{4, 5, 6}, [3]int{a, b, c})
+	is.ErrorIs(err, sentinelErr)
+}
+
+func TestTryOrErr4(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	sentinelErr := errors.New("sentinel")
+
+	a, b, c, d, err := TryOrErr4(func() (int, int, int, int, error) { return 1, 2, 3, 4, nil }, 0, 0, 0, 0)
+	is.Equal([4]int{1, 2, 3, 4}, [4]int{a, b, c, d})
+	is.NoError(err)
+
+	a, b, c, d, err = TryOrErr4(func() (int, int, int, int, error) { return 0, 0, 0, 0, sentinelErr }, 5, 6, 7, 8)
+	is.Equal([4]int{5, 6, 7, 8}, [4]int{a, b, c, d})
+	is.ErrorIs(err, sentinelErr)
+}
+
+func TestTryOrErr5(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	sentinelErr := errors.New("sentinel")
+
+	a, b, c, d, e, err := TryOrErr5(func() (int, int, int, int, int, error) { return 1, 2, 3, 4, 5, nil }, 0, 0, 0, 0, 0)
+	is.Equal([5]int{1, 2, 3, 4, 5}, [5]int{a, b, c, d, e})
+	is.NoError(err)
+
+	a, b, c, d, e, err = TryOrErr5(func() (int, int, int, int, int, error) { return 0, 0, 0, 0, 0, sentinelErr }, 6, 7, 8, 9, 10)
+	is.Equal([5]int{6, 7, 8, 9, 10}, [5]int{a, b, c, d, e})
+	is.ErrorIs(err, sentinelErr)
+}
+
+func TestTryOrErr6(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	sentinelErr := errors.New("sentinel")
+
+	a, b, c, d, e, f, err := TryOrErr6(func() (int, int, int, int, int, int, error) { return 1, 2, 3, 4, 5, 6, nil }, 0, 0, 0, 0, 0, 0)
+	is.Equal([6]int{1, 2, 3, 4, 5, 6}, [6]int{a, b, c, d, e, f})
+	is.NoError(err)
+
+	a, b, c, d, e, f, err = TryOrErr6(func() (int, int, int, int, int, int, error) { return 0, 0, 0, 0, 0, 0, sentinelErr }, 7, 8, 9, 10, 11, 12)
+	is.Equal([6]int{7, 8, 9, 10, 11, 12}, [6]int{a, b, c, d, e, f})
+	is.ErrorIs(err, sentinelErr)
+}
+
+func TestAsError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	sentinelErr := errors.New("sentinel")
+	is.Equal(sentinelErr, AsError(sentinelErr))
+	is.EqualError(AsError("boom"), "boom")
+	is.EqualError(AsError(42), "42")
+}
+
@@ -0,0 +1,134 @@
+package lo
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Validator accumulates named validation rules and aggregates their
+// failures into a single ValidationError.
+type Validator struct {
+	errs  map[string][]error
+	order []string
+}
+
+// NewValidator returns an empty Validator ready to accumulate rules.
+func NewValidator() *Validator {
+	return &Validator{errs: map[string][]error{}}
+}
+
+// Field returns a fieldValidator scoped to name, so rules can be attached
+// with Require/RequireFunc.
+func (v *Validator) Field(name string) *fieldValidator {
+	return &fieldValidator{v: v, field: name}
+}
+
+func (v *Validator) addErr(field string, err error) {
+	if _, ok := v.errs[field]; !ok {
+		v.order = append(v.order, field)
+	}
+	v.errs[field] = append(v.errs[field], err)
+}
+
+// Err returns nil when every rule passed, or a *ValidationError aggregating
+// every failure otherwise.
+func (v *Validator) Err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return &ValidationError{errs: v.errs, order: v.order}
+}
+
+// fieldValidator attaches rules to a single named field of a Validator.
+type fieldValidator struct {
+	v     *Validator
+	field string
+}
+
+// Require records a failure for this field when ok is false.
+func (f *fieldValidator) Require(ok bool, msg string, args ...any) *fieldValidator {
+	if !ok {
+		f.v.addErr(f.field, LoErrorF(msg, args...))
+	}
+	return f
+}
+
+// RequireFunc records a failure for this field when check returns false. The
+// error returned by check, if any, becomes the field error; otherwise a
+// generic "invalid" error is recorded.
+func (f *fieldValidator) RequireFunc(check func() (bool, error)) *fieldValidator {
+	ok, err := check()
+	if !ok {
+		if err == nil {
+			err = LoErrorF("invalid")
+		}
+		f.v.addErr(f.field, err)
+	}
+	return f
+}
+
+// ValidationError aggregates every failed rule of a Validator, keyed by
+// field name.
+type ValidationError struct {
+	errs  map[string][]error
+	order []string
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.order))
+	for _, field := range e.order {
+		for _, err := range e.errs[field] {
+			if field == "" {
+				msgs = append(msgs, err.Error())
+				continue
+			}
+			msgs = append(msgs, field+": "+err.Error())
+		}
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Fields returns every failure message, keyed by field name.
+func (e *ValidationError) Fields() map[string][]string {
+	out := make(map[string][]string, len(e.order))
+	for _, field := range e.order {
+		msgs := make([]string, 0, len(e.errs[field]))
+		for _, err := range e.errs[field] {
+			msgs = append(msgs, err.Error())
+		}
+		out[field] = msgs
+	}
+	return out
+}
+
+// Unwrap exposes every field error so errors.Is/errors.As can reach any one
+// of them.
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, 0, len(e.order))
+	for _, field := range e.order {
+		errs = append(errs, e.errs[field]...)
+	}
+	return errs
+}
+
+// MarshalJSON implements json.Marshaler, producing {"field": ["msg1", ...]}
+// for use directly in HTTP handlers.
+func (e *ValidationError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Fields())
+}
+
+// Validate is a helper that creates an error when a condition is not met.
+// Play: https://go.dev/play/p/vPyh51XpCBt
+func Validate(ok bool, format string, args ...any) error {
+	v := NewValidator()
+	v.Field("").Require(ok, format, args...)
+	return v.Err()
+}
+
+// MustValidate panics through LoPanic with v's aggregated ValidationError
+// when at least one of its rules failed.
+func MustValidate(v *Validator) {
+	if err := v.Err(); err != nil {
+		LoPanic(err)
+	}
+}
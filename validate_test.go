@@ -0,0 +1,115 @@
+package lo
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.NoError(Validate(true, "should not fail"))
+	is.EqualError(Validate(false, "user %s not found", "123"), "user 123 not found")
+}
+
+func TestValidator(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v := NewValidator()
+	v.Field("name").Require(true, "required")
+	v.Field("age").Require(false, "must be >= %d", 18)
+	is.Error(v.Err())
+
+	v = NewValidator()
+	v.Field("name").Require(true, "required")
+	is.NoError(v.Err())
+}
+
+func TestValidatorRequireFunc(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	sentinelErr := errors.New("sentinel")
+
+	v := NewValidator()
+	v.Field("email").RequireFunc(func() (bool, error) { return false, sentinelErr })
+	err := v.Err()
+	is.ErrorIs(err, sentinelErr)
+
+	v = NewValidator()
+	v.Field("email").RequireFunc(func() (bool, error) { return false, nil })
+	is.EqualError(v.Err(), "email: invalid")
+
+	v = NewValidator()
+	v.Field("email").RequireFunc(func() (bool, error) { return true, nil })
+	is.NoError(v.Err())
+}
+
+func TestValidationErrorFields(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v := NewValidator()
+	v.Field("name").Require(false, "required")
+	v.Field("age").Require(false, "must be >= %d", 18)
+	v.Field("age").Require(false, "must be an integer")
+
+	verr, ok := v.Err().(*ValidationError)
+	is.True(ok)
+
+	fields := verr.Fields()
+	is.Equal([]string{"required"}, fields["name"])
+	is.Equal([]string{"must be >= 18", "must be an integer"}, fields["age"])
+}
+
+func TestValidationErrorUnwrap(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	sentinelErr := errors.New("sentinel")
+
+	v := NewValidator()
+	v.Field("name").Require(false, "required")
+	v.Field("email").RequireFunc(func() (bool, error) { return false, sentinelErr })
+
+	err := v.Err()
+	is.ErrorIs(err, sentinelErr)
+
+	var verr *ValidationError
+	is.True(errors.As(err, &verr))
+	is.Len(verr.Unwrap(), 2)
+}
+
+func TestValidationErrorMarshalJSON(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v := NewValidator()
+	v.Field("name").Require(false, "required")
+
+	data, err := json.Marshal(v.Err())
+	is.NoError(err)
+	is.JSONEq(`{"name": ["required"]}`, string(data))
+}
+
+func TestMustValidate(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.NotPanics(func() {
+		v := NewValidator()
+		v.Field("name").Require(true, "required")
+		MustValidate(v)
+	})
+
+	is.Panics(func() {
+		v := NewValidator()
+		v.Field("name").Require(false, "required")
+		MustValidate(v)
+	})
+}